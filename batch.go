@@ -0,0 +1,83 @@
+package notionapi
+
+import (
+	"context"
+	"sync"
+)
+
+// Batch queues a series of page mutations and flushes them with bounded
+// concurrency, so a caller migrating or syncing many pages doesn't have to
+// hand-roll a worker pool. Each queued mutation still goes through the
+// Client's normal retry/backoff path, so a rate-limited (429) mutation
+// waits out Retry-After before retrying. That backoff is per request:
+// beyond the concurrency bound, nothing is shared across the batch.
+type Batch struct {
+	client      *Client
+	concurrency int
+	mutations   []func(ctx context.Context) (Page, error)
+}
+
+// NewBatch creates a Batch that runs up to concurrency mutations at once.
+// concurrency <= 0 is treated as 1 (sequential).
+func (c *Client) NewBatch(concurrency int) *Batch {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Batch{client: c, concurrency: concurrency}
+}
+
+// CreatePage queues a CreatePage call.
+func (b *Batch) CreatePage(databaseID string, properties map[string]interface{}, opts ...WriteOption) {
+	b.mutations = append(b.mutations, func(ctx context.Context) (Page, error) {
+		return b.client.CreatePage(ctx, databaseID, properties, opts...)
+	})
+}
+
+// UpdatePage queues an UpdatePage call.
+func (b *Batch) UpdatePage(pageID string, properties map[string]interface{}, opts ...WriteOption) {
+	b.mutations = append(b.mutations, func(ctx context.Context) (Page, error) {
+		return b.client.UpdatePage(ctx, pageID, properties, opts...)
+	})
+}
+
+// ArchivePage queues an ArchivePage call.
+func (b *Batch) ArchivePage(pageID string, opts ...WriteOption) {
+	b.mutations = append(b.mutations, func(ctx context.Context) (Page, error) {
+		return b.client.ArchivePage(ctx, pageID, opts...)
+	})
+}
+
+// Len returns the number of mutations currently queued.
+func (b *Batch) Len() int {
+	return len(b.mutations)
+}
+
+// BatchResult is one mutation's outcome, in the order it was queued.
+type BatchResult struct {
+	Page Page
+	Err  error
+}
+
+// Flush runs every queued mutation, up to the Batch's concurrency limit at
+// once, and returns their results in queue order. It does not stop early
+// if a mutation fails; check each BatchResult's Err.
+func (b *Batch) Flush(ctx context.Context) []BatchResult {
+	results := make([]BatchResult, len(b.mutations))
+	sem := make(chan struct{}, b.concurrency)
+	var wg sync.WaitGroup
+
+	for i, mutation := range b.mutations {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, mutation func(ctx context.Context) (Page, error)) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			page, err := mutation(ctx)
+			results[i] = BatchResult{Page: page, Err: err}
+		}(i, mutation)
+	}
+
+	wg.Wait()
+	b.mutations = nil
+	return results
+}
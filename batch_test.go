@@ -0,0 +1,79 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatch_FlushRunsAllInQueueOrder(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"id":"ok"}`, nil), nil
+	})
+
+	b := client.NewBatch(2)
+	b.CreatePage("db1", map[string]interface{}{"Name": "a"})
+	b.CreatePage("db1", map[string]interface{}{"Name": "b"})
+	b.ArchivePage("page3")
+
+	if got := b.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+
+	results := b.Flush(context.Background())
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Errorf("result %d: unexpected error %v", i, r.Err)
+		}
+		if r.Page["id"] != "ok" {
+			t.Errorf("result %d: page = %v", i, r.Page)
+		}
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() after Flush = %d, want 0 (queue should be cleared)", b.Len())
+	}
+}
+
+func TestBatch_FlushRespectsConcurrencyLimit(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		return jsonResponse(http.StatusOK, `{"id":"ok"}`, nil), nil
+	})
+
+	b := client.NewBatch(2)
+	for i := 0; i < 10; i++ {
+		b.CreatePage("db1", map[string]interface{}{"Name": fmt.Sprintf("p%d", i)})
+	}
+	b.Flush(context.Background())
+
+	if maxInFlight > 2 {
+		t.Errorf("max concurrent requests = %d, want <= 2", maxInFlight)
+	}
+}
+
+func TestBatch_ZeroConcurrencyRunsSequentially(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"id":"ok"}`, nil), nil
+	})
+
+	b := client.NewBatch(0)
+	b.CreatePage("db1", nil)
+	results := b.Flush(context.Background())
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("got %v", results)
+	}
+}
@@ -0,0 +1,148 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	defaultBaseURL       = "https://api.notion.com/v1"
+	defaultNotionVersion = "2022-06-28"
+	defaultTimeout       = 10 * time.Second
+)
+
+// Config holds the credentials needed to talk to a single Notion database.
+// It is normally loaded with LoadConfig, but callers are free to build one
+// in-memory (e.g. from environment variables) and pass its NotionToken to
+// NewClient.
+type Config struct {
+	NotionToken string `json:"notion_token"`
+	DatabaseID  string `json:"notion_database_id"`
+}
+
+// Client is a Notion API client. A Client is safe for concurrent use by
+// multiple goroutines, and a process may create several Clients (for
+// different tokens or databases) at once.
+type Client struct {
+	token              string
+	httpClient         *http.Client
+	httpClientProvided bool
+	notionVersion      string
+	baseURL            string
+	logger             Logger
+	responseDumpDir    string
+}
+
+// Option configures a Client. Options are applied in the order passed to
+// NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to talk to the Notion API.
+// The default is an *http.Client with a 10 second timeout. Once applied, it
+// takes ownership of hc away from WithTimeout: a WithTimeout passed after
+// WithHTTPClient is a no-op, so callers sharing hc across several Clients
+// don't get its Timeout silently rewritten by whichever Client was
+// constructed last.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+		c.httpClientProvided = true
+	}
+}
+
+// WithNotionVersion overrides the Notion-Version header sent with every
+// request. The default is "2022-06-28".
+func WithNotionVersion(version string) Option {
+	return func(c *Client) { c.notionVersion = version }
+}
+
+// WithBaseURL overrides the Notion API base URL. Mainly useful for pointing
+// the client at a test server. The default is "https://api.notion.com/v1".
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = baseURL }
+}
+
+// WithTimeout sets the timeout on the default http.Client that NewClient
+// creates. It has no effect when WithHTTPClient is also passed, in either
+// order: a timeout set before WithHTTPClient stays on the default client
+// that WithHTTPClient then replaces, and one set after it is a no-op rather
+// than mutating a client the library doesn't own — set the timeout on the
+// supplied *http.Client instead.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		if c.httpClientProvided {
+			return
+		}
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithLogger installs a Logger that the Client uses to report what it's
+// doing. The default Client is silent.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) { c.logger = logger }
+}
+
+// WithResponseDumpDir makes QueryDatabase write each raw page response it
+// receives, pretty-printed, to a numbered JSON file in dir. This is off by
+// default; it exists for debugging, not as a persistence mechanism.
+func WithResponseDumpDir(dir string) Option {
+	return func(c *Client) { c.responseDumpDir = dir }
+}
+
+// NewClient creates a Client authenticated with token. token must not be
+// empty; everything else can be customized with Option values.
+func NewClient(token string, opts ...Option) (*Client, error) {
+	if token == "" {
+		return nil, fmt.Errorf("notionapi: token is required")
+	}
+
+	c := &Client{
+		token:         token,
+		httpClient:    &http.Client{Timeout: defaultTimeout},
+		notionVersion: defaultNotionVersion,
+		baseURL:       defaultBaseURL,
+		logger:        discardLogger{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// NewClientFromConfigFile reads a Config from the JSON file at path and
+// returns a Client authenticated with it.
+func NewClientFromConfigFile(path string, opts ...Option) (*Client, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg.NotionToken, opts...)
+}
+
+// LoadConfig reads and validates a Config from the JSON file at path.
+func LoadConfig(path string) (*Config, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(file, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if cfg.NotionToken == "" {
+		return nil, fmt.Errorf("notion_token is missing in %s", path)
+	}
+	if cfg.DatabaseID == "" {
+		return nil, fmt.Errorf("notion_database_id is missing in %s", path)
+	}
+
+	return &cfg, nil
+}
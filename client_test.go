@@ -0,0 +1,103 @@
+package notionapi
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewClient_RequiresToken(t *testing.T) {
+	if _, err := NewClient(""); err == nil {
+		t.Fatal("expected error for empty token")
+	}
+}
+
+func TestNewClient_Defaults(t *testing.T) {
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if c.baseURL != defaultBaseURL {
+		t.Errorf("baseURL = %q, want %q", c.baseURL, defaultBaseURL)
+	}
+	if c.notionVersion != defaultNotionVersion {
+		t.Errorf("notionVersion = %q, want %q", c.notionVersion, defaultNotionVersion)
+	}
+	if c.httpClient.Timeout != defaultTimeout {
+		t.Errorf("timeout = %v, want %v", c.httpClient.Timeout, defaultTimeout)
+	}
+}
+
+func TestNewClient_Options(t *testing.T) {
+	c, err := NewClient("test-token",
+		WithBaseURL("http://example.invalid"),
+		WithNotionVersion("2024-01-01"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if c.baseURL != "http://example.invalid" {
+		t.Errorf("baseURL = %q, want override", c.baseURL)
+	}
+	if c.notionVersion != "2024-01-01" {
+		t.Errorf("notionVersion = %q, want override", c.notionVersion)
+	}
+}
+
+func TestWithTimeout_AppliedToDefaultClient(t *testing.T) {
+	c, err := NewClient("test-token", WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if c.httpClient.Timeout != 5*time.Second {
+		t.Errorf("timeout = %v, want 5s", c.httpClient.Timeout)
+	}
+}
+
+func TestWithTimeout_NoOpAfterWithHTTPClient(t *testing.T) {
+	shared := &http.Client{Timeout: 2 * time.Second}
+
+	_, err := NewClient("test-token", WithHTTPClient(shared), WithTimeout(99*time.Second))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if shared.Timeout != 2*time.Second {
+		t.Errorf("shared client's Timeout = %v, want unchanged 2s (WithTimeout must not mutate a caller-supplied http.Client)", shared.Timeout)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"notion_token":"tok","notion_database_id":"db"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.NotionToken != "tok" || cfg.DatabaseID != "db" {
+		t.Fatalf("got %+v, want token=tok database_id=db", cfg)
+	}
+}
+
+func TestLoadConfig_MissingFields(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"notion_token":"tok"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Fatal("expected error for missing notion_database_id")
+	}
+}
+
+func TestLoadConfig_MissingFile(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
@@ -0,0 +1,256 @@
+package filter
+
+import "time"
+
+// TextFilter builds conditions for title, rich_text, url, email, and
+// phone_number properties, which all share the same text operators.
+type TextFilter struct {
+	name string
+	path []string
+}
+
+func (t *TextFilter) build(cond map[string]interface{}) Filter {
+	return propertyFilter(t.name, t.path, cond)
+}
+
+func (t *TextFilter) Equals(v string) Filter { return t.build(map[string]interface{}{"equals": v}) }
+func (t *TextFilter) DoesNotEqual(v string) Filter {
+	return t.build(map[string]interface{}{"does_not_equal": v})
+}
+func (t *TextFilter) Contains(v string) Filter { return t.build(map[string]interface{}{"contains": v}) }
+func (t *TextFilter) DoesNotContain(v string) Filter {
+	return t.build(map[string]interface{}{"does_not_contain": v})
+}
+func (t *TextFilter) StartsWith(v string) Filter {
+	return t.build(map[string]interface{}{"starts_with": v})
+}
+func (t *TextFilter) EndsWith(v string) Filter {
+	return t.build(map[string]interface{}{"ends_with": v})
+}
+func (t *TextFilter) IsEmpty() Filter { return t.build(map[string]interface{}{"is_empty": true}) }
+func (t *TextFilter) IsNotEmpty() Filter {
+	return t.build(map[string]interface{}{"is_not_empty": true})
+}
+
+// NumberFilter builds conditions for number properties.
+type NumberFilter struct {
+	name string
+	path []string
+}
+
+func (n *NumberFilter) build(cond map[string]interface{}) Filter {
+	return propertyFilter(n.name, n.path, cond)
+}
+
+func (n *NumberFilter) Equals(v float64) Filter { return n.build(map[string]interface{}{"equals": v}) }
+func (n *NumberFilter) DoesNotEqual(v float64) Filter {
+	return n.build(map[string]interface{}{"does_not_equal": v})
+}
+func (n *NumberFilter) GreaterThan(v float64) Filter {
+	return n.build(map[string]interface{}{"greater_than": v})
+}
+func (n *NumberFilter) LessThan(v float64) Filter {
+	return n.build(map[string]interface{}{"less_than": v})
+}
+func (n *NumberFilter) GreaterThanOrEqualTo(v float64) Filter {
+	return n.build(map[string]interface{}{"greater_than_or_equal_to": v})
+}
+func (n *NumberFilter) LessThanOrEqualTo(v float64) Filter {
+	return n.build(map[string]interface{}{"less_than_or_equal_to": v})
+}
+func (n *NumberFilter) IsEmpty() Filter { return n.build(map[string]interface{}{"is_empty": true}) }
+func (n *NumberFilter) IsNotEmpty() Filter {
+	return n.build(map[string]interface{}{"is_not_empty": true})
+}
+
+// CheckboxFilter builds conditions for checkbox properties.
+type CheckboxFilter struct {
+	name string
+	path []string
+}
+
+func (c *CheckboxFilter) build(cond map[string]interface{}) Filter {
+	return propertyFilter(c.name, c.path, cond)
+}
+
+func (c *CheckboxFilter) Equals(v bool) Filter { return c.build(map[string]interface{}{"equals": v}) }
+func (c *CheckboxFilter) DoesNotEqual(v bool) Filter {
+	return c.build(map[string]interface{}{"does_not_equal": v})
+}
+
+// SelectFilter builds conditions for select and status properties.
+type SelectFilter struct {
+	name string
+	path []string
+}
+
+func (s *SelectFilter) build(cond map[string]interface{}) Filter {
+	return propertyFilter(s.name, s.path, cond)
+}
+
+func (s *SelectFilter) Equals(v string) Filter { return s.build(map[string]interface{}{"equals": v}) }
+func (s *SelectFilter) DoesNotEqual(v string) Filter {
+	return s.build(map[string]interface{}{"does_not_equal": v})
+}
+func (s *SelectFilter) IsEmpty() Filter { return s.build(map[string]interface{}{"is_empty": true}) }
+func (s *SelectFilter) IsNotEmpty() Filter {
+	return s.build(map[string]interface{}{"is_not_empty": true})
+}
+
+// MultiSelectFilter builds conditions for multi_select and relation
+// properties.
+type MultiSelectFilter struct {
+	name string
+	path []string
+}
+
+func (m *MultiSelectFilter) build(cond map[string]interface{}) Filter {
+	return propertyFilter(m.name, m.path, cond)
+}
+
+func (m *MultiSelectFilter) Contains(v string) Filter {
+	return m.build(map[string]interface{}{"contains": v})
+}
+func (m *MultiSelectFilter) DoesNotContain(v string) Filter {
+	return m.build(map[string]interface{}{"does_not_contain": v})
+}
+func (m *MultiSelectFilter) IsEmpty() Filter {
+	return m.build(map[string]interface{}{"is_empty": true})
+}
+func (m *MultiSelectFilter) IsNotEmpty() Filter {
+	return m.build(map[string]interface{}{"is_not_empty": true})
+}
+
+// DateFilter builds conditions for date properties and the created_time/
+// last_edited_time timestamps.
+type DateFilter struct {
+	name      string
+	path      []string
+	timestamp bool
+}
+
+func (d *DateFilter) build(cond map[string]interface{}) Filter {
+	wrapped := cond
+	for i := len(d.path) - 1; i >= 0; i-- {
+		wrapped = map[string]interface{}{d.path[i]: wrapped}
+	}
+	if d.timestamp {
+		wrapped["timestamp"] = d.name
+	} else {
+		wrapped["property"] = d.name
+	}
+	return Filter{value: wrapped}
+}
+
+func (d *DateFilter) Equals(t time.Time) Filter {
+	return d.build(map[string]interface{}{"equals": t.Format(time.RFC3339)})
+}
+func (d *DateFilter) Before(t time.Time) Filter {
+	return d.build(map[string]interface{}{"before": t.Format(time.RFC3339)})
+}
+func (d *DateFilter) After(t time.Time) Filter {
+	return d.build(map[string]interface{}{"after": t.Format(time.RFC3339)})
+}
+func (d *DateFilter) OnOrBefore(t time.Time) Filter {
+	return d.build(map[string]interface{}{"on_or_before": t.Format(time.RFC3339)})
+}
+func (d *DateFilter) OnOrAfter(t time.Time) Filter {
+	return d.build(map[string]interface{}{"on_or_after": t.Format(time.RFC3339)})
+}
+func (d *DateFilter) IsEmpty() Filter { return d.build(map[string]interface{}{"is_empty": true}) }
+func (d *DateFilter) IsNotEmpty() Filter {
+	return d.build(map[string]interface{}{"is_not_empty": true})
+}
+func (d *DateFilter) ThisWeek() Filter {
+	return d.build(map[string]interface{}{"this_week": map[string]interface{}{}})
+}
+func (d *DateFilter) PastWeek() Filter {
+	return d.build(map[string]interface{}{"past_week": map[string]interface{}{}})
+}
+func (d *DateFilter) PastMonth() Filter {
+	return d.build(map[string]interface{}{"past_month": map[string]interface{}{}})
+}
+func (d *DateFilter) PastYear() Filter {
+	return d.build(map[string]interface{}{"past_year": map[string]interface{}{}})
+}
+func (d *DateFilter) NextWeek() Filter {
+	return d.build(map[string]interface{}{"next_week": map[string]interface{}{}})
+}
+func (d *DateFilter) NextMonth() Filter {
+	return d.build(map[string]interface{}{"next_month": map[string]interface{}{}})
+}
+func (d *DateFilter) NextYear() Filter {
+	return d.build(map[string]interface{}{"next_year": map[string]interface{}{}})
+}
+
+// FormulaFilter targets a formula property; pick the formula's declared
+// result type to get its operators.
+type FormulaFilter struct {
+	name string
+}
+
+func (f *FormulaFilter) String() *TextFilter {
+	return &TextFilter{name: f.name, path: []string{"formula", "string"}}
+}
+func (f *FormulaFilter) Number() *NumberFilter {
+	return &NumberFilter{name: f.name, path: []string{"formula", "number"}}
+}
+func (f *FormulaFilter) Checkbox() *CheckboxFilter {
+	return &CheckboxFilter{name: f.name, path: []string{"formula", "checkbox"}}
+}
+func (f *FormulaFilter) Date() *DateFilter {
+	return &DateFilter{name: f.name, path: []string{"formula", "date"}}
+}
+
+// RollupFilter targets a rollup property. Any/Every/None build a condition
+// over each item of an array rollup, given the underlying property type
+// (e.g. "rich_text", "number"); Number/Date build a condition over an
+// aggregated (sum, count, ...) rollup.
+type RollupFilter struct {
+	name string
+}
+
+func (r *RollupFilter) Any(propType string) *RollupCondition {
+	return &RollupCondition{name: r.name, path: []string{"rollup", "any", propType}}
+}
+func (r *RollupFilter) Every(propType string) *RollupCondition {
+	return &RollupCondition{name: r.name, path: []string{"rollup", "every", propType}}
+}
+func (r *RollupFilter) None(propType string) *RollupCondition {
+	return &RollupCondition{name: r.name, path: []string{"rollup", "none", propType}}
+}
+func (r *RollupFilter) Number() *NumberFilter {
+	return &NumberFilter{name: r.name, path: []string{"rollup", "number"}}
+}
+func (r *RollupFilter) Date() *DateFilter {
+	return &DateFilter{name: r.name, path: []string{"rollup", "date"}}
+}
+
+// RollupCondition builds a condition over each item of an array rollup.
+// The underlying property type isn't known at compile time, so operators
+// here take interface{} rather than a specific Go type.
+type RollupCondition struct {
+	name string
+	path []string
+}
+
+func (r *RollupCondition) build(cond map[string]interface{}) Filter {
+	return propertyFilter(r.name, r.path, cond)
+}
+
+func (r *RollupCondition) Equals(v interface{}) Filter {
+	return r.build(map[string]interface{}{"equals": v})
+}
+func (r *RollupCondition) Contains(v interface{}) Filter {
+	return r.build(map[string]interface{}{"contains": v})
+}
+func (r *RollupCondition) GreaterThan(v interface{}) Filter {
+	return r.build(map[string]interface{}{"greater_than": v})
+}
+func (r *RollupCondition) LessThan(v interface{}) Filter {
+	return r.build(map[string]interface{}{"less_than": v})
+}
+func (r *RollupCondition) IsEmpty() Filter { return r.build(map[string]interface{}{"is_empty": true}) }
+func (r *RollupCondition) IsNotEmpty() Filter {
+	return r.build(map[string]interface{}{"is_not_empty": true})
+}
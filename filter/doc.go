@@ -0,0 +1,12 @@
+// Package filter builds Notion database query filters and sorts without
+// callers hand-assembling the request JSON:
+//
+//	f := filter.And(
+//		filter.Property("Status").Status().Equals("Done"),
+//		filter.Property("Price").Number().GreaterThan(10),
+//	)
+//
+// A Filter (or a []Sort) marshals to the shape Notion's API expects, so it
+// can be dropped directly into a notionapi.QueryRequest's Filter or Sorts
+// field.
+package filter
@@ -0,0 +1,139 @@
+package filter
+
+import "encoding/json"
+
+// Filter is a single Notion database query filter condition, or a
+// combination of them built with And/Or. It marshals to the JSON shape
+// Notion's API expects.
+type Filter struct {
+	value map[string]interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.value)
+}
+
+// And combines filters so all of them must match.
+func And(filters ...Filter) Filter {
+	return compound("and", filters)
+}
+
+// Or combines filters so at least one of them must match.
+func Or(filters ...Filter) Filter {
+	return compound("or", filters)
+}
+
+func compound(op string, filters []Filter) Filter {
+	values := make([]map[string]interface{}, len(filters))
+	for i, f := range filters {
+		values[i] = f.value
+	}
+	return Filter{value: map[string]interface{}{op: values}}
+}
+
+// propertyFilter wraps cond in path (outermost first) and tags the result
+// with "property": name, e.g. path ["formula", "string"] and cond
+// {"contains": "x"} produce
+// {"property": name, "formula": {"string": {"contains": "x"}}}.
+func propertyFilter(name string, path []string, cond map[string]interface{}) Filter {
+	wrapped := cond
+	for i := len(path) - 1; i >= 0; i-- {
+		wrapped = map[string]interface{}{path[i]: wrapped}
+	}
+	wrapped["property"] = name
+	return Filter{value: wrapped}
+}
+
+// PropertyFilter names the property a condition will be built against; it
+// has no filter of its own until a property-type method (Title, Number,
+// ...) picks an operator set.
+type PropertyFilter struct {
+	name string
+}
+
+// Property starts building a filter condition on the property named name.
+func Property(name string) *PropertyFilter {
+	return &PropertyFilter{name: name}
+}
+
+// Title targets a title property's text operators.
+func (p *PropertyFilter) Title() *TextFilter {
+	return &TextFilter{name: p.name, path: []string{"title"}}
+}
+
+// RichText targets a rich_text property's text operators.
+func (p *PropertyFilter) RichText() *TextFilter {
+	return &TextFilter{name: p.name, path: []string{"rich_text"}}
+}
+
+// URL targets a url property's text operators.
+func (p *PropertyFilter) URL() *TextFilter { return &TextFilter{name: p.name, path: []string{"url"}} }
+
+// Email targets an email property's text operators.
+func (p *PropertyFilter) Email() *TextFilter {
+	return &TextFilter{name: p.name, path: []string{"email"}}
+}
+
+// PhoneNumber targets a phone_number property's text operators.
+func (p *PropertyFilter) PhoneNumber() *TextFilter {
+	return &TextFilter{name: p.name, path: []string{"phone_number"}}
+}
+
+// Number targets a number property's range operators.
+func (p *PropertyFilter) Number() *NumberFilter {
+	return &NumberFilter{name: p.name, path: []string{"number"}}
+}
+
+// Checkbox targets a checkbox property.
+func (p *PropertyFilter) Checkbox() *CheckboxFilter {
+	return &CheckboxFilter{name: p.name, path: []string{"checkbox"}}
+}
+
+// Select targets a select property.
+func (p *PropertyFilter) Select() *SelectFilter {
+	return &SelectFilter{name: p.name, path: []string{"select"}}
+}
+
+// Status targets a status property; it has the same operators as Select.
+func (p *PropertyFilter) Status() *SelectFilter {
+	return &SelectFilter{name: p.name, path: []string{"status"}}
+}
+
+// MultiSelect targets a multi_select property.
+func (p *PropertyFilter) MultiSelect() *MultiSelectFilter {
+	return &MultiSelectFilter{name: p.name, path: []string{"multi_select"}}
+}
+
+// Relation targets a relation property; it has the same operators as
+// MultiSelect.
+func (p *PropertyFilter) Relation() *MultiSelectFilter {
+	return &MultiSelectFilter{name: p.name, path: []string{"relation"}}
+}
+
+// Date targets a date property.
+func (p *PropertyFilter) Date() *DateFilter {
+	return &DateFilter{name: p.name, path: []string{"date"}}
+}
+
+// Formula targets a formula property; pick the formula's result type (
+// String, Number, Checkbox, or Date) to get its operators.
+func (p *PropertyFilter) Formula() *FormulaFilter {
+	return &FormulaFilter{name: p.name}
+}
+
+// Rollup targets a rollup property; pick Any/Every/None for an
+// array rollup, or Number/Date for an aggregated one.
+func (p *PropertyFilter) Rollup() *RollupFilter {
+	return &RollupFilter{name: p.name}
+}
+
+// CreatedTime filters on a page's created_time timestamp.
+func CreatedTime() *DateFilter {
+	return &DateFilter{name: "created_time", path: []string{"created_time"}, timestamp: true}
+}
+
+// LastEditedTime filters on a page's last_edited_time timestamp.
+func LastEditedTime() *DateFilter {
+	return &DateFilter{name: "last_edited_time", path: []string{"last_edited_time"}, timestamp: true}
+}
@@ -0,0 +1,136 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func marshal(t *testing.T, v interface{}) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	return out
+}
+
+func TestTextFilter_Contains(t *testing.T) {
+	got := marshal(t, Property("Name").Title().Contains("launch"))
+	want := map[string]interface{}{
+		"property": "Name",
+		"title":    map[string]interface{}{"contains": "launch"},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestNumberFilter_GreaterThan(t *testing.T) {
+	got := marshal(t, Property("Price").Number().GreaterThan(10))
+	want := map[string]interface{}{
+		"property": "Price",
+		"number":   map[string]interface{}{"greater_than": float64(10)},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSelectFilter_Status(t *testing.T) {
+	got := marshal(t, Property("State").Status().Equals("Done"))
+	want := map[string]interface{}{
+		"property": "State",
+		"status":   map[string]interface{}{"equals": "Done"},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFormulaFilter_String(t *testing.T) {
+	got := marshal(t, Property("Calc").Formula().String().Equals("x"))
+	want := map[string]interface{}{
+		"property": "Calc",
+		"formula": map[string]interface{}{
+			"string": map[string]interface{}{"equals": "x"},
+		},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRollupFilter_Any(t *testing.T) {
+	got := marshal(t, Property("Items").Rollup().Any("number").GreaterThan(float64(1)))
+	want := map[string]interface{}{
+		"property": "Items",
+		"rollup": map[string]interface{}{
+			"any": map[string]interface{}{
+				"number": map[string]interface{}{"greater_than": float64(1)},
+			},
+		},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDateFilter_Timestamp(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := marshal(t, CreatedTime().After(ts))
+	want := map[string]interface{}{
+		"timestamp":    "created_time",
+		"created_time": map[string]interface{}{"after": ts.Format(time.RFC3339)},
+	}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAndOr(t *testing.T) {
+	f := And(
+		Property("Status").Select().Equals("Done"),
+		Or(
+			Property("Price").Number().GreaterThan(0),
+			Property("Tags").MultiSelect().Contains("urgent"),
+		),
+	)
+
+	got := marshal(t, f)
+	and, ok := got["and"].([]interface{})
+	if !ok || len(and) != 2 {
+		t.Fatalf("got %v, want a 2-element \"and\" array", got)
+	}
+	second := and[1].(map[string]interface{})
+	or, ok := second["or"].([]interface{})
+	if !ok || len(or) != 2 {
+		t.Fatalf("got %v, want a nested 2-element \"or\" array", second)
+	}
+}
+
+func TestSort_Helpers(t *testing.T) {
+	got := marshal(t, Ascending("Name"))
+	want := map[string]interface{}{"property": "Name", "direction": "ascending"}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = marshal(t, CreatedTimeDescending())
+	want = map[string]interface{}{"timestamp": "created_time", "direction": "descending"}
+	if !jsonEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// jsonEqual compares a and b by their canonical JSON encoding, since Go's
+// json.Marshal sorts map keys, sidestepping map iteration order.
+func jsonEqual(a, b map[string]interface{}) bool {
+	aj, _ := json.Marshal(a)
+	bj, _ := json.Marshal(b)
+	return string(aj) == string(bj)
+}
@@ -0,0 +1,44 @@
+package filter
+
+import "encoding/json"
+
+// Sort is a single entry in a database query's sort order. It marshals to
+// the JSON shape Notion's API expects.
+type Sort struct {
+	value map[string]interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Sort) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.value)
+}
+
+// PropertySort sorts by property in the given direction ("ascending" or
+// "descending"); prefer Ascending/Descending unless direction is dynamic.
+func PropertySort(property, direction string) Sort {
+	return Sort{value: map[string]interface{}{"property": property, "direction": direction}}
+}
+
+// Ascending sorts by property, lowest first.
+func Ascending(property string) Sort { return PropertySort(property, "ascending") }
+
+// Descending sorts by property, highest first.
+func Descending(property string) Sort { return PropertySort(property, "descending") }
+
+// TimestampSort sorts by a timestamp ("created_time" or "last_edited_time")
+// in the given direction.
+func TimestampSort(timestamp, direction string) Sort {
+	return Sort{value: map[string]interface{}{"timestamp": timestamp, "direction": direction}}
+}
+
+// CreatedTimeAscending sorts by created_time, oldest first.
+func CreatedTimeAscending() Sort { return TimestampSort("created_time", "ascending") }
+
+// CreatedTimeDescending sorts by created_time, newest first.
+func CreatedTimeDescending() Sort { return TimestampSort("created_time", "descending") }
+
+// LastEditedTimeAscending sorts by last_edited_time, oldest first.
+func LastEditedTimeAscending() Sort { return TimestampSort("last_edited_time", "ascending") }
+
+// LastEditedTimeDescending sorts by last_edited_time, newest first.
+func LastEditedTimeDescending() Sort { return TimestampSort("last_edited_time", "descending") }
@@ -0,0 +1,25 @@
+package notionapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+)
+
+// newJSONRequest builds a request with the standard JSON headers Notion
+// expects. payload may be nil for bodyless requests (e.g. GET).
+func newJSONRequest(ctx context.Context, method, url string, payload []byte) (*http.Request, error) {
+	var body *bytes.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
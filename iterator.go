@@ -0,0 +1,172 @@
+package notionapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Page is a single Notion page, as returned in a database query's "results"
+// array.
+type Page = map[string]interface{}
+
+// QueryRequest describes a database query. Filter and Sorts are marshaled
+// as-is into the request body, so callers should pass a filter.Filter / a
+// []filter.Sort from the notionapi/filter package (or a hand-built
+// map[string]interface{}, for anything the builders don't cover yet).
+type QueryRequest struct {
+	DatabaseID  string      `json:"-"`
+	Filter      interface{} `json:"filter,omitempty"`
+	Sorts       interface{} `json:"sorts,omitempty"`
+	PageSize    int         `json:"page_size,omitempty"`
+	StartCursor string      `json:"start_cursor,omitempty"`
+}
+
+// QueryDatabase returns an iterator over the pages matching req. Pages are
+// fetched lazily, one Notion API page (batch) at a time, as the caller
+// advances the iterator with Next; nothing is fetched until the first call
+// to Next.
+func (c *Client) QueryDatabase(ctx context.Context, req *QueryRequest) *PageIterator {
+	return &PageIterator{
+		client:  c,
+		req:     req,
+		cursor:  req.StartCursor,
+		hasMore: true,
+	}
+}
+
+// PageIterator streams the pages of a database query, fetching additional
+// batches from Notion as needed. The zero value is not usable; create one
+// with Client.QueryDatabase.
+type PageIterator struct {
+	client  *Client
+	req     *QueryRequest
+	batch   []Page
+	idx     int
+	cursor  string
+	hasMore bool
+	started bool
+	batchNo int
+	err     error
+}
+
+// Next advances the iterator and reports whether a page is available. It
+// blocks on network I/O when the current batch is exhausted and more pages
+// remain. Callers should stop iterating as soon as Next returns false and
+// check Err to distinguish "no more pages" from a failed request.
+func (it *PageIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.idx < len(it.batch) {
+		it.idx++
+		return true
+	}
+
+	for {
+		if it.started && !it.hasMore {
+			return false
+		}
+		it.started = true
+
+		batch, hasMore, nextCursor, err := it.client.fetchQueryPage(ctx, it.req, it.cursor, it.batchNo)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.batch = batch
+		it.idx = 0
+		it.hasMore = hasMore
+		it.cursor = nextCursor
+		it.batchNo++
+
+		if len(it.batch) > 0 {
+			it.idx++
+			return true
+		}
+		// Notion can return a batch with zero results but has_more still
+		// true; keep fetching rather than truncating iteration early.
+	}
+}
+
+// Page returns the page Next just advanced to. It must only be called after
+// a call to Next that returned true.
+func (it *PageIterator) Page() Page {
+	return it.batch[it.idx-1]
+}
+
+// Err returns the first error encountered while fetching pages, or nil if
+// iteration stopped because there were no more pages.
+func (it *PageIterator) Err() error {
+	return it.err
+}
+
+// HasMore reports whether Notion had more results as of the last fetched
+// batch, letting callers checkpoint progress without exhausting the
+// iterator.
+func (it *PageIterator) HasMore() bool {
+	return it.hasMore
+}
+
+// NextCursor returns the start_cursor to resume from after the last fetched
+// batch. It is the empty string once HasMore is false.
+func (it *PageIterator) NextCursor() string {
+	return it.cursor
+}
+
+// fetchQueryPage fetches a single batch of a database query at cursor and
+// returns its pages along with Notion's pagination state.
+func (c *Client) fetchQueryPage(ctx context.Context, req *QueryRequest, cursor string, batchNo int) ([]Page, bool, string, error) {
+	body := *req
+	body.StartCursor = cursor
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, false, "", err
+	}
+
+	url := c.baseURL + "/databases/" + req.DatabaseID + "/query"
+	c.logger.Printf("notionapi: querying database %s (cursor=%q)", req.DatabaseID, cursor)
+
+	respBody, err := c.doRequest(ctx, "POST", url, payload, nil)
+	if err != nil {
+		return nil, false, "", err
+	}
+
+	if c.responseDumpDir != "" {
+		if err := c.dumpResponse(req.DatabaseID, batchNo, respBody); err != nil {
+			c.logger.Printf("notionapi: failed to dump response: %v", err)
+		}
+	}
+
+	var result struct {
+		Results    []Page `json:"results"`
+		HasMore    bool   `json:"has_more"`
+		NextCursor string `json:"next_cursor"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, false, "", err
+	}
+
+	return result.Results, result.HasMore, result.NextCursor, nil
+}
+
+// dumpResponse pretty-prints body to a numbered JSON file under
+// c.responseDumpDir, for debugging.
+func (c *Client) dumpResponse(databaseID string, batchNo int, body []byte) error {
+	if err := os.MkdirAll(c.responseDumpDir, 0o755); err != nil {
+		return err
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, body, "", "    "); err != nil {
+		pretty.Write(body)
+	}
+
+	name := fmt.Sprintf("%s-%03d.json", databaseID, batchNo)
+	return os.WriteFile(filepath.Join(c.responseDumpDir, name), pretty.Bytes(), 0o644)
+}
@@ -0,0 +1,62 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestPageIterator_SkipsEmptyBatchWithMore reproduces Notion returning a
+// batch with zero results but has_more still true: the iterator must keep
+// fetching instead of stopping early.
+func TestPageIterator_SkipsEmptyBatchWithMore(t *testing.T) {
+	responses := []string{
+		`{"results":[],"has_more":true,"next_cursor":"page-2"}`,
+		`{"results":[{"id":"p1"},{"id":"p2"}],"has_more":false,"next_cursor":null}`,
+	}
+	call := 0
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if call >= len(responses) {
+			return nil, fmt.Errorf("unexpected extra request %d", call)
+		}
+		resp := jsonResponse(http.StatusOK, responses[call], nil)
+		call++
+		return resp, nil
+	})
+
+	it := client.QueryDatabase(context.Background(), &QueryRequest{DatabaseID: "db1"})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		page := it.Page()
+		ids = append(ids, page["id"].(string))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("iteration failed: %v", err)
+	}
+	if call != 2 {
+		t.Fatalf("expected iterator to fetch 2 batches, fetched %d", call)
+	}
+	if len(ids) != 2 || ids[0] != "p1" || ids[1] != "p2" {
+		t.Fatalf("unexpected pages: %v", ids)
+	}
+	if it.HasMore() {
+		t.Fatal("HasMore should be false once iteration is exhausted")
+	}
+}
+
+func TestPageIterator_StopsWhenNoMore(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"results":[],"has_more":false,"next_cursor":null}`, nil), nil
+	})
+
+	it := client.QueryDatabase(context.Background(), &QueryRequest{DatabaseID: "db1"})
+	if it.Next(context.Background()) {
+		t.Fatal("expected no pages")
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+package notionapi
+
+// Logger is the logging interface the Client reports its activity through.
+// *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// discardLogger is the default Logger: it drops everything it's given.
+type discardLogger struct{}
+
+func (discardLogger) Printf(format string, v ...interface{}) {}
@@ -1,147 +1,29 @@
 package notionapi
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"os"
 	"strings"
 	"time"
 )
 
-// Config structure for reading JSON file
-type Config struct {
-	NotionToken string `json:"notion_token"`
-	DatabaseID  string `json:"notion_database_id"`
-}
-
-var notionToken string
-var databaseID string
-var notionAPIURL string
-
-var client = &http.Client{Timeout: 10 * time.Second}
-
-// Load config.json
-func LoadConfig() error {
-	// ✅ Clear `api_response.json` at the start
-	if err := os.WriteFile("api_response.json", []byte("{}"), 0644); err != nil {
-		fmt.Println("❌ Failed to clear `api_response.json`:", err)
-	} else {
-		fmt.Println("🗑 Cleared `api_response.json` before fetching new data")
-	}
-
-	file, err := os.ReadFile("config.json")
-	if err != nil {
-		return fmt.Errorf("failed to read config.json: %w", err)
-	}
-
-	var config Config
-	if err := json.Unmarshal(file, &config); err != nil {
-		return fmt.Errorf("failed to parse config.json: %w", err)
-	}
-
-	// Debugging: Print loaded values
-	fmt.Println("🔍 DEBUG: Loaded Notion Token:", config.NotionToken)
-	fmt.Println("🔍 DEBUG: Loaded Database ID:", config.DatabaseID)
-
-	// Ensure values are not empty
-	if config.NotionToken == "" {
-		return fmt.Errorf("notion_token is missing in config.json")
-	}
-	if config.DatabaseID == "" {
-		return fmt.Errorf("notion_database_id is missing in config.json")
+// FetchDatabase queries databaseID and returns every page in it, following
+// pagination until Notion reports no more results. It is a convenience
+// wrapper around QueryDatabase for callers who don't need to stream or
+// checkpoint; for large databases or long-running processes, prefer
+// QueryDatabase directly.
+func (c *Client) FetchDatabase(ctx context.Context, databaseID string) ([]Page, error) {
+	it := c.QueryDatabase(ctx, &QueryRequest{DatabaseID: databaseID})
+
+	var pages []Page
+	for it.Next(ctx) {
+		pages = append(pages, it.Page())
 	}
-
-	// Set global variables
-	notionToken = config.NotionToken
-	databaseID = config.DatabaseID
-	notionAPIURL = "https://api.notion.com/v1/databases/" + databaseID + "/query"
-
-	fmt.Println("🔍 DEBUG: Notion API URL:", notionAPIURL)
-	fmt.Println("✅ Config loaded successfully!")
-	return nil
-}
-
-// Fetch Notion Data (Supports Pagination)
-func FetchNotionData() ([]map[string]interface{}, error) {
-	var allData []map[string]interface{}
-	hasMore := true
-	startCursor := ""
-
-	for hasMore {
-		payload := map[string]interface{}{
-			"page_size": 100,
-		}
-		if startCursor != "" {
-			payload["start_cursor"] = startCursor
-		}
-
-		payloadBytes, _ := json.Marshal(payload)
-		req, err := http.NewRequest("POST", notionAPIURL, bytes.NewReader(payloadBytes))
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Authorization", "Bearer "+notionToken)
-		req.Header.Set("Notion-Version", "2022-06-28")
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
-
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		// ✅ Format JSON before saving
-		var formattedJSON bytes.Buffer
-		if err := json.Indent(&formattedJSON, body, "", "    "); err != nil {
-			fmt.Println("❌ Failed to format JSON:", err)
-			return nil, err
-		}
-
-		// ✅ Write formatted API response to `api_response.json`
-		if err := os.WriteFile("api_response.json", formattedJSON.Bytes(), 0644); err != nil {
-			fmt.Println("❌ Failed to write API response to file:", err)
-		} else {
-			fmt.Println("📁 API response saved and formatted in `api_response.json`")
-		}
-
-		var result map[string]interface{}
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, err
-		}
-
-		if results, ok := result["results"].([]interface{}); ok {
-			for _, r := range results {
-				if page, ok := r.(map[string]interface{}); ok {
-					allData = append(allData, page)
-				}
-			}
-		}
-
-		// ✅ Safe type assertion for "has_more"
-		if hasMoreVal, ok := result["has_more"].(bool); ok {
-			hasMore = hasMoreVal
-		} else {
-			hasMore = false
-		}
-
-		// ✅ Safe check for "next_cursor"
-		if nextCursor, ok := result["next_cursor"].(string); ok {
-			startCursor = nextCursor
-		} else {
-			startCursor = ""
-		}
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
 
-	return allData, nil
+	return pages, nil
 }
 
 // Fetch Name (Page Title)
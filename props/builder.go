@@ -0,0 +1,127 @@
+// Package props provides a fluent builder for Notion page property
+// payloads, so callers don't have to hand-assemble the JSON shape Notion
+// expects for each property type.
+//
+//	properties := props.New().
+//		Title("Name", "Foo").
+//		Number("Price", 9.99).
+//		MultiSelect("Tags", "a", "b").
+//		Date("Due", time.Now()).
+//		Build()
+package props
+
+import "time"
+
+// Builder accumulates property values by name. The zero value is not
+// usable; create one with New.
+type Builder struct {
+	fields map[string]interface{}
+}
+
+// New returns an empty Builder.
+func New() *Builder {
+	return &Builder{fields: make(map[string]interface{})}
+}
+
+// Build returns the accumulated properties, shaped as the Notion API
+// expects for a page's "properties" field.
+func (b *Builder) Build() map[string]interface{} {
+	return b.fields
+}
+
+// Title sets a title property.
+func (b *Builder) Title(name, text string) *Builder {
+	b.fields[name] = map[string]interface{}{
+		"title": richText(text),
+	}
+	return b
+}
+
+// RichText sets a rich_text property.
+func (b *Builder) RichText(name, text string) *Builder {
+	b.fields[name] = map[string]interface{}{
+		"rich_text": richText(text),
+	}
+	return b
+}
+
+// Number sets a number property.
+func (b *Builder) Number(name string, value float64) *Builder {
+	b.fields[name] = map[string]interface{}{"number": value}
+	return b
+}
+
+// Checkbox sets a checkbox property.
+func (b *Builder) Checkbox(name string, value bool) *Builder {
+	b.fields[name] = map[string]interface{}{"checkbox": value}
+	return b
+}
+
+// Select sets a select property to a single option.
+func (b *Builder) Select(name, option string) *Builder {
+	b.fields[name] = map[string]interface{}{
+		"select": map[string]interface{}{"name": option},
+	}
+	return b
+}
+
+// Status sets a status property.
+func (b *Builder) Status(name, option string) *Builder {
+	b.fields[name] = map[string]interface{}{
+		"status": map[string]interface{}{"name": option},
+	}
+	return b
+}
+
+// MultiSelect sets a multi_select property to the given options.
+func (b *Builder) MultiSelect(name string, options ...string) *Builder {
+	opts := make([]map[string]interface{}, len(options))
+	for i, option := range options {
+		opts[i] = map[string]interface{}{"name": option}
+	}
+	b.fields[name] = map[string]interface{}{"multi_select": opts}
+	return b
+}
+
+// Date sets a date property to a single point in time (no end date).
+func (b *Builder) Date(name string, t time.Time) *Builder {
+	b.fields[name] = map[string]interface{}{
+		"date": map[string]interface{}{"start": t.Format(time.RFC3339)},
+	}
+	return b
+}
+
+// URL sets a url property.
+func (b *Builder) URL(name, url string) *Builder {
+	b.fields[name] = map[string]interface{}{"url": url}
+	return b
+}
+
+// Email sets an email property.
+func (b *Builder) Email(name, email string) *Builder {
+	b.fields[name] = map[string]interface{}{"email": email}
+	return b
+}
+
+// PhoneNumber sets a phone_number property.
+func (b *Builder) PhoneNumber(name, phone string) *Builder {
+	b.fields[name] = map[string]interface{}{"phone_number": phone}
+	return b
+}
+
+// People sets a people property to the given workspace member IDs.
+func (b *Builder) People(name string, personIDs ...string) *Builder {
+	people := make([]map[string]interface{}, len(personIDs))
+	for i, id := range personIDs {
+		people[i] = map[string]interface{}{"id": id}
+	}
+	b.fields[name] = map[string]interface{}{"people": people}
+	return b
+}
+
+// richText builds a single-element rich_text/title array containing text.
+func richText(text string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{"text": map[string]interface{}{"content": text}},
+	}
+}
@@ -0,0 +1,72 @@
+package props
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	got := New().
+		Title("Name", "Launch").
+		RichText("Notes", "details").
+		Number("Price", 9.99).
+		Checkbox("Done", true).
+		Select("Status", "In Progress").
+		MultiSelect("Tags", "a", "b").
+		Date("Due", due).
+		URL("Link", "https://example.com").
+		Email("Contact", "a@example.com").
+		PhoneNumber("Phone", "+15555555555").
+		People("Owner", "user1", "user2").
+		Build()
+
+	if len(got) != 11 {
+		t.Fatalf("got %d fields, want 11: %v", len(got), got)
+	}
+
+	title := got["Name"].(map[string]interface{})["title"].([]map[string]interface{})
+	if title[0]["text"].(map[string]interface{})["content"] != "Launch" {
+		t.Errorf("Title: %v", title)
+	}
+
+	if got["Price"].(map[string]interface{})["number"] != 9.99 {
+		t.Errorf("Number: %v", got["Price"])
+	}
+
+	if got["Done"].(map[string]interface{})["checkbox"] != true {
+		t.Errorf("Checkbox: %v", got["Done"])
+	}
+
+	sel := got["Status"].(map[string]interface{})["select"].(map[string]interface{})
+	if sel["name"] != "In Progress" {
+		t.Errorf("Select: %v", sel)
+	}
+
+	tags := got["Tags"].(map[string]interface{})["multi_select"].([]map[string]interface{})
+	if len(tags) != 2 || tags[0]["name"] != "a" || tags[1]["name"] != "b" {
+		t.Errorf("MultiSelect: %v", tags)
+	}
+
+	date := got["Due"].(map[string]interface{})["date"].(map[string]interface{})
+	if date["start"] != due.Format(time.RFC3339) {
+		t.Errorf("Date: %v", date)
+	}
+
+	if got["Link"].(map[string]interface{})["url"] != "https://example.com" {
+		t.Errorf("URL: %v", got["Link"])
+	}
+
+	people := got["Owner"].(map[string]interface{})["people"].([]map[string]interface{})
+	if len(people) != 2 || people[0]["id"] != "user1" {
+		t.Errorf("People: %v", people)
+	}
+}
+
+func TestBuilder_Status(t *testing.T) {
+	got := New().Status("State", "Done").Build()
+	status := got["State"].(map[string]interface{})["status"].(map[string]interface{})
+	if status["name"] != "Done" {
+		t.Errorf("Status: %v", status)
+	}
+}
@@ -0,0 +1,137 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxAttempts bounds how many times a single request is retried before
+// doRequest gives up and returns the last error.
+const maxAttempts = 5
+
+// isRetryableStatus reports whether a Notion response with the given status
+// code should be retried: 429 (rate limited) and any 5xx.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// backoffDelay returns an exponential backoff delay for attempt (0-indexed),
+// with up to 50% jitter added to avoid thundering-herd retries.
+func backoffDelay(attempt int) time.Duration {
+	base := 250 * time.Millisecond * time.Duration(1<<uint(attempt))
+	if base > 30*time.Second {
+		base = 30 * time.Second
+	}
+	jitter := time.Duration(rand.Int63n(int64(base)/2 + 1))
+	return base + jitter
+}
+
+// retryDelay honors a Retry-After header when present (Notion sends it as a
+// number of seconds on 429 responses) and falls back to backoffDelay.
+func retryDelay(retryAfter string, attempt int) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	return backoffDelay(attempt)
+}
+
+// sleep waits for d, returning early with ctx.Err() if ctx is cancelled
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// doRequest sends payload to url, retrying on transient network errors, 429
+// (honoring Retry-After), and 5xx responses with exponential backoff and
+// jitter. It returns the response body of the first successful response,
+// or an error (carrying the body) for the first non-retryable 4xx status.
+// Extra headers (e.g. Idempotency-Key) may be passed via headers, which may
+// be nil.
+func (c *Client) doRequest(ctx context.Context, method, url string, payload []byte, headers map[string]string) ([]byte, error) {
+	var lastErr error
+	// overrideDelay, when haveOverride is true, replaces the default
+	// backoff for the upcoming sleep; set when a 429 response names a
+	// Retry-After. Both are consumed (and reset) at the top of the next
+	// iteration, so a 429 is never slept for twice. A plain time.Duration
+	// can't distinguish "no override" from "override to zero delay", hence
+	// the separate bool.
+	var overrideDelay time.Duration
+	var haveOverride bool
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := backoffDelay(attempt - 1)
+			if haveOverride {
+				delay = overrideDelay
+			}
+			haveOverride = false
+
+			c.logger.Printf("notionapi: retrying %s %s (attempt %d/%d): %v", method, url, attempt+1, maxAttempts, lastErr)
+			if err := sleep(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
+		body, retryAfter, status, err := c.doOnce(ctx, method, url, payload, headers)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if isRetryableStatus(status) {
+			lastErr = fmt.Errorf("notionapi: request failed with status %d: %s", status, body)
+			if status == http.StatusTooManyRequests {
+				overrideDelay = retryDelay(retryAfter, attempt)
+				haveOverride = true
+			}
+			continue
+		}
+
+		if status >= 400 {
+			return nil, fmt.Errorf("notionapi: request failed with status %d: %s", status, body)
+		}
+
+		return body, nil
+	}
+
+	return nil, fmt.Errorf("notionapi: giving up after %d attempts: %w", maxAttempts, lastErr)
+}
+
+func (c *Client) doOnce(ctx context.Context, method, url string, payload []byte, headers map[string]string) (body []byte, retryAfter string, status int, err error) {
+	req, err := newJSONRequest(ctx, method, url, payload)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Notion-Version", c.notionVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	return body, resp.Header.Get("Retry-After"), resp.StatusCode, nil
+}
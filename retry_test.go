@@ -0,0 +1,118 @@
+package notionapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc lets a test supply a fake transport without a real server.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func jsonResponse(status int, body string, headers map[string]string) *http.Response {
+	resp := &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func newTestClient(t *testing.T, rt roundTripFunc) *Client {
+	t.Helper()
+	c, err := NewClient("test-token", WithHTTPClient(&http.Client{Transport: rt}))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestDoRequest_RetryAfterSleepsOnce(t *testing.T) {
+	var calls []time.Time
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, time.Now())
+		if len(calls) == 1 {
+			return jsonResponse(http.StatusTooManyRequests, `{}`, map[string]string{"Retry-After": "0"}), nil
+		}
+		return jsonResponse(http.StatusOK, `{"ok":true}`, nil), nil
+	})
+
+	start := time.Now()
+	body, err := client.doRequest(context.Background(), "GET", "http://example.invalid", nil, nil)
+	if err != nil {
+		t.Fatalf("doRequest: %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(calls))
+	}
+
+	// A Retry-After of 0 seconds should not also incur a full exponential
+	// backoff sleep (the first backoff step is 250ms); the whole retry
+	// should complete well under that.
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Fatalf("retry took %s, want well under 200ms (double sleep regression)", elapsed)
+	}
+}
+
+func TestDoRequest_RetriesOn5xxThenSucceeds(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts < 3 {
+			return jsonResponse(http.StatusInternalServerError, `{}`, nil), nil
+		}
+		return jsonResponse(http.StatusOK, `{"ok":true}`, nil), nil
+	})
+
+	body, err := client.doRequest(context.Background(), "GET", "http://example.invalid", nil, nil)
+	if err != nil {
+		t.Fatalf("doRequest: %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestDoRequest_NonRetryableStatusFailsFast(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return jsonResponse(http.StatusBadRequest, `{"message":"bad"}`, nil), nil
+	})
+
+	_, err := client.doRequest(context.Background(), "GET", "http://example.invalid", nil, nil)
+	if err == nil {
+		t.Fatal("expected error for 400 response")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt for a non-retryable status, got %d", attempts)
+	}
+}
+
+func TestDoRequest_HonorsContextCancellation(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusTooManyRequests, `{}`, map[string]string{"Retry-After": "5"}), nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.doRequest(ctx, "GET", "http://example.invalid", nil, nil)
+	if err == nil {
+		t.Fatal("expected context deadline error")
+	}
+}
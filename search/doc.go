@@ -0,0 +1,13 @@
+// Package search builds an in-memory inverted index over Notion pages
+// fetched with notionapi, so a database can be queried offline without
+// hitting the API on every search.
+//
+//	idx := search.NewIndex()
+//	idx.Add(page)
+//	results := idx.Query(`foo AND "bar baz"`)
+//
+// Index, KVStore, and Mapping are deliberately separate: Mapping decides
+// which Notion property names get indexed and how (Text vs. Keyword),
+// Index holds the postings and evaluates queries, and KVStore is the
+// optional persistence boundary an Index can be backed by.
+package search
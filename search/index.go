@@ -0,0 +1,336 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// indexKey is the KVStore key the whole index is persisted under. The
+// index is small enough (it's built from already-fetched Notion pages,
+// not used as a database of record) that round-tripping it as one blob is
+// simpler than a per-posting key scheme.
+const indexKey = "notionapi/search/index"
+
+// posting records that term occurs in a document's field at the given
+// token positions, so phrase queries can check adjacency.
+type posting struct {
+	docID     string
+	positions []int
+}
+
+// Index is an in-memory inverted index over Notion pages, optionally
+// persisted to a KVStore. The zero value is not usable; create one with
+// NewIndex.
+type Index struct {
+	mu       sync.RWMutex
+	mapping  *Mapping
+	boosts   map[string]float64
+	store    KVStore
+	pages    map[string]map[string]interface{}
+	postings map[string]map[string][]posting // field -> term -> postings
+}
+
+// Option configures an Index.
+type Option func(*Index)
+
+// WithMapping overrides the default auto-detected Mapping with an explicit
+// one, so only the declared properties are indexed.
+func WithMapping(m *Mapping) Option {
+	return func(idx *Index) { idx.mapping = m }
+}
+
+// WithFieldBoost multiplies the score contributed by matches in field by
+// boost. The default boost for every field is 1.0.
+func WithFieldBoost(field string, boost float64) Option {
+	return func(idx *Index) { idx.boosts[field] = boost }
+}
+
+// WithStore makes the Index persist itself to store after every Add,
+// and load any previously persisted index on creation.
+func WithStore(store KVStore) Option {
+	return func(idx *Index) { idx.store = store }
+}
+
+// NewIndex creates an empty Index, or restores one previously persisted
+// with WithStore.
+func NewIndex(opts ...Option) *Index {
+	idx := &Index{
+		boosts:   make(map[string]float64),
+		pages:    make(map[string]map[string]interface{}),
+		postings: make(map[string]map[string][]posting),
+	}
+	for _, opt := range opts {
+		opt(idx)
+	}
+
+	if idx.store != nil {
+		idx.load()
+	}
+
+	return idx
+}
+
+// Add indexes page. If the Index was created without an explicit Mapping,
+// each page is mapped individually with DefaultMapping, so heterogeneous
+// databases (pages with different property sets) are all searchable.
+func (idx *Index) Add(page map[string]interface{}) error {
+	id, _ := page["id"].(string)
+	if id == "" {
+		return fmt.Errorf("search: page has no \"id\"")
+	}
+
+	mapping := idx.mapping
+	if mapping == nil {
+		mapping = DefaultMapping(page)
+	}
+
+	props, _ := page["properties"].(map[string]interface{})
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if _, exists := idx.pages[id]; exists {
+		idx.removePostingsLocked(id)
+	}
+
+	idx.pages[id] = page
+	for name, kind := range mapping.fields {
+		propObj, ok := props[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		text, ok := extractText(propObj)
+		if !ok || text == "" {
+			continue
+		}
+		idx.indexField(id, name, kind, text)
+	}
+
+	if idx.store != nil {
+		return idx.persistLocked()
+	}
+	return nil
+}
+
+func (idx *Index) indexField(docID, field string, kind FieldKind, text string) {
+	if idx.postings[field] == nil {
+		idx.postings[field] = make(map[string][]posting)
+	}
+
+	var tokens []string
+	if kind == Keyword {
+		tokens = []string{strings.ToLower(strings.TrimSpace(text))}
+	} else {
+		tokens = tokenize(text)
+	}
+
+	positions := make(map[string][]int)
+	for pos, tok := range tokens {
+		positions[tok] = append(positions[tok], pos)
+	}
+
+	for term, pos := range positions {
+		idx.postings[field][term] = append(idx.postings[field][term], posting{docID: docID, positions: pos})
+	}
+}
+
+// removePostingsLocked drops every posting for docID across all fields and
+// terms, so a re-Add of an already-indexed page doesn't leave stale entries
+// behind alongside the freshly indexed ones. Callers must hold idx.mu.
+func (idx *Index) removePostingsLocked(docID string) {
+	for field, terms := range idx.postings {
+		for term, postings := range terms {
+			kept := postings[:0]
+			for _, p := range postings {
+				if p.docID != docID {
+					kept = append(kept, p)
+				}
+			}
+			if len(kept) == 0 {
+				delete(terms, term)
+			} else {
+				terms[term] = kept
+			}
+		}
+		if len(terms) == 0 {
+			delete(idx.postings, field)
+		}
+	}
+}
+
+// tokenize lowercases text and splits it into word tokens.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+}
+
+// Result is a single match returned by Query, ranked by Score.
+type Result struct {
+	DocID string
+	Score float64
+}
+
+// Query evaluates a term/phrase/boolean query over the index and returns
+// matching pages ranked by score, highest first. Terms are plain words
+// ("foo"), phrases are double-quoted ("\"foo bar\""), and AND/OR/NOT
+// combine clauses left to right, e.g. `foo AND "bar baz" NOT qux`. A bare
+// sequence of clauses with no operator between them is implicitly ANDed.
+func (idx *Index) Query(query string) []Result {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	clauses := parseQuery(query)
+	if len(clauses) == 0 {
+		return nil
+	}
+
+	scores := idx.matchClause(clauses[0])
+	for _, cl := range clauses[1:] {
+		matches := idx.matchClause(cl)
+		switch cl.op {
+		case opOr:
+			scores = union(scores, matches)
+		case opNot:
+			scores = subtract(scores, matches)
+		default: // opAnd, and bare juxtaposition
+			scores = intersect(scores, matches)
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for docID, score := range scores {
+		results = append(results, Result{DocID: docID, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].DocID < results[j].DocID
+	})
+	return results
+}
+
+// Page returns the raw page last added under docID, and whether it was
+// found.
+func (idx *Index) Page(docID string) (map[string]interface{}, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	page, ok := idx.pages[docID]
+	return page, ok
+}
+
+func (idx *Index) boost(field string) float64 {
+	if b, ok := idx.boosts[field]; ok {
+		return b
+	}
+	return 1.0
+}
+
+func (idx *Index) matchClause(cl clause) map[string]float64 {
+	if cl.phrase {
+		return idx.matchPhrase(cl.terms)
+	}
+	return idx.matchTerm(cl.terms[0])
+}
+
+func (idx *Index) matchTerm(term string) map[string]float64 {
+	scores := make(map[string]float64)
+	term = strings.ToLower(term)
+	for field, terms := range idx.postings {
+		for _, p := range terms[term] {
+			scores[p.docID] += idx.boost(field) * float64(len(p.positions))
+		}
+	}
+	return scores
+}
+
+// matchPhrase requires every term to occur, within a single field, at
+// consecutive positions.
+func (idx *Index) matchPhrase(terms []string) map[string]float64 {
+	scores := make(map[string]float64)
+	if len(terms) == 0 {
+		return scores
+	}
+	for i, t := range terms {
+		terms[i] = strings.ToLower(t)
+	}
+
+	for field, postingsByTerm := range idx.postings {
+		// A Keyword field holds a multi-word value as one token, so match
+		// the phrase as a whole against it too.
+		if len(terms) > 1 {
+			for _, p := range postingsByTerm[strings.Join(terms, " ")] {
+				scores[p.docID] += idx.boost(field)
+			}
+		}
+		first, ok := postingsByTerm[terms[0]]
+		if !ok {
+			continue
+		}
+		for _, p := range first {
+			for _, start := range p.positions {
+				if phraseMatchesAt(postingsByTerm, terms, p.docID, start) {
+					scores[p.docID] += idx.boost(field)
+				}
+			}
+		}
+	}
+	return scores
+}
+
+func phraseMatchesAt(postingsByTerm map[string][]posting, terms []string, docID string, start int) bool {
+	for i := 1; i < len(terms); i++ {
+		if !hasPosition(postingsByTerm[terms[i]], docID, start+i) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasPosition(postings []posting, docID string, pos int) bool {
+	for _, p := range postings {
+		if p.docID != docID {
+			continue
+		}
+		for _, candidate := range p.positions {
+			if candidate == pos {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func union(a, b map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(a)+len(b))
+	for k, v := range a {
+		out[k] += v
+	}
+	for k, v := range b {
+		out[k] += v
+	}
+	return out
+}
+
+func intersect(a, b map[string]float64) map[string]float64 {
+	out := make(map[string]float64)
+	for k, v := range a {
+		if bv, ok := b[k]; ok {
+			out[k] = v + bv
+		}
+	}
+	return out
+}
+
+func subtract(a, b map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(a))
+	for k, v := range a {
+		if _, excluded := b[k]; !excluded {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package search
+
+import "testing"
+
+func titlePage(id, text string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id,
+		"properties": map[string]interface{}{
+			"Name": map[string]interface{}{
+				"title": []interface{}{map[string]interface{}{"plain_text": text}},
+			},
+		},
+	}
+}
+
+// TestIndex_ReAddReplacesPostings reproduces re-indexing a page under the
+// same id: the old postings must be gone, not merged with the new ones,
+// or a stale term keeps matching and scores double-count.
+func TestIndex_ReAddReplacesPostings(t *testing.T) {
+	idx := NewIndex()
+
+	if err := idx.Add(titlePage("p1", "alpha")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if results := idx.Query("alpha"); len(results) != 1 {
+		t.Fatalf("after first Add: got %d results for \"alpha\", want 1", len(results))
+	}
+
+	if err := idx.Add(titlePage("p1", "beta")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if results := idx.Query("alpha"); len(results) != 0 {
+		t.Fatalf("after re-Add: \"alpha\" still matches p1, stale posting not cleared: %v", results)
+	}
+
+	results := idx.Query("beta")
+	if len(results) != 1 || results[0].DocID != "p1" {
+		t.Fatalf("after re-Add: got %v, want a single match on p1", results)
+	}
+	if results[0].Score != 1.0 {
+		t.Fatalf("after re-Add: score %v, want 1.0 (no double-counting from the stale posting)", results[0].Score)
+	}
+}
+
+func TestIndex_QueryAndBoolean(t *testing.T) {
+	idx := NewIndex()
+	if err := idx.Add(titlePage("p1", "launch day plan")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := idx.Add(titlePage("p2", "launch retro")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	results := idx.Query("launch NOT retro")
+	if len(results) != 1 || results[0].DocID != "p1" {
+		t.Fatalf("got %v, want only p1", results)
+	}
+}
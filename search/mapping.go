@@ -0,0 +1,53 @@
+package search
+
+// FieldKind controls how a property's text is analyzed when it's indexed.
+type FieldKind int
+
+const (
+	// Text fields are lowercased and tokenized on word boundaries, so
+	// "Product Launch" matches a query for "launch".
+	Text FieldKind = iota
+	// Keyword fields are indexed as a single lowercased token, so they
+	// only match an exact (case-insensitive) query for the whole value,
+	// quoted as a phrase when the value has several words.
+	// Useful for statuses, IDs, and other fields queries shouldn't split.
+	Keyword
+)
+
+// Mapping declares which Notion property names get indexed, and as which
+// FieldKind. Properties not added to a Mapping are ignored by Index.Add.
+type Mapping struct {
+	fields map[string]FieldKind
+}
+
+// NewMapping returns an empty Mapping. Chain calls to Index to declare
+// fields.
+func NewMapping() *Mapping {
+	return &Mapping{fields: make(map[string]FieldKind)}
+}
+
+// Index declares that property should be indexed as kind, and returns the
+// Mapping so calls can be chained.
+func (m *Mapping) Index(property string, kind FieldKind) *Mapping {
+	m.fields[property] = kind
+	return m
+}
+
+// DefaultMapping indexes the property types the package knows how to read
+// text out of (title, rich_text, select, status, multi_select, and text-bearing
+// rollups) as Text fields, keyed by their Notion property name. It's used
+// when an Index is created without an explicit Mapping.
+func DefaultMapping(page map[string]interface{}) *Mapping {
+	m := NewMapping()
+	props, _ := page["properties"].(map[string]interface{})
+	for name, raw := range props {
+		propObj, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := extractText(propObj); ok {
+			m.Index(name, Text)
+		}
+	}
+	return m
+}
@@ -0,0 +1,72 @@
+package search
+
+import "encoding/json"
+
+// snapshot is the JSON-serializable form of an Index, written to the
+// configured KVStore as a single blob under indexKey.
+type snapshot struct {
+	Pages    map[string]map[string]interface{}   `json:"pages"`
+	Postings map[string]map[string][]snapPosting `json:"postings"`
+}
+
+type snapPosting struct {
+	DocID     string `json:"doc_id"`
+	Positions []int  `json:"positions"`
+}
+
+// persistLocked serializes the index and writes it to idx.store. Callers
+// must hold idx.mu.
+func (idx *Index) persistLocked() error {
+	snap := snapshot{
+		Pages:    idx.pages,
+		Postings: make(map[string]map[string][]snapPosting, len(idx.postings)),
+	}
+	for field, terms := range idx.postings {
+		snap.Postings[field] = make(map[string][]snapPosting, len(terms))
+		for term, postings := range terms {
+			converted := make([]snapPosting, len(postings))
+			for i, p := range postings {
+				converted[i] = snapPosting{DocID: p.docID, Positions: p.positions}
+			}
+			snap.Postings[field][term] = converted
+		}
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	return idx.store.Set(indexKey, data)
+}
+
+// load restores a previously persisted index from idx.store. A missing or
+// unreadable snapshot just leaves the Index empty, since the store is an
+// optional cache, not the source of truth for a Notion database.
+func (idx *Index) load() {
+	data, err := idx.store.Get(indexKey)
+	if err != nil {
+		return
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if snap.Pages != nil {
+		idx.pages = snap.Pages
+	}
+	for field, terms := range snap.Postings {
+		idx.postings[field] = make(map[string][]posting, len(terms))
+		for term, postings := range terms {
+			converted := make([]posting, len(postings))
+			for i, p := range postings {
+				converted[i] = posting{docID: p.DocID, positions: p.Positions}
+			}
+			idx.postings[field][term] = converted
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+type booleanOp int
+
+const (
+	opAnd booleanOp = iota
+	opOr
+	opNot
+)
+
+// clause is a single term or phrase in a query, joined to the previous
+// clause by op (ignored for the first clause).
+type clause struct {
+	op     booleanOp
+	terms  []string // one term, or every word of a phrase
+	phrase bool
+}
+
+// parseQuery tokenizes a query string into clauses. Double-quoted spans are
+// phrases; AND/OR/NOT (case-sensitive) set the operator joining the next
+// clause to the ones before it; anything else is a bare term implicitly
+// ANDed in.
+func parseQuery(query string) []clause {
+	var clauses []clause
+	pendingOp := opAnd
+
+	for _, tok := range splitQueryTokens(query) {
+		switch tok {
+		case "AND":
+			pendingOp = opAnd
+		case "OR":
+			pendingOp = opOr
+		case "NOT":
+			pendingOp = opNot
+		default:
+			if strings.HasPrefix(tok, `"`) && strings.HasSuffix(tok, `"`) && len(tok) >= 2 {
+				phrase := strings.Trim(tok, `"`)
+				clauses = append(clauses, clause{op: pendingOp, terms: strings.Fields(phrase), phrase: true})
+			} else {
+				clauses = append(clauses, clause{op: pendingOp, terms: []string{tok}})
+			}
+			pendingOp = opAnd
+		}
+	}
+
+	return clauses
+}
+
+// splitQueryTokens splits on whitespace, keeping double-quoted phrases
+// (including their internal spaces) as a single token.
+func splitQueryTokens(query string) []string {
+	var tokens []string
+	var cur strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			cur.WriteRune(r)
+			inQuotes = !inQuotes
+			if !inQuotes {
+				flush()
+			}
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
@@ -0,0 +1,113 @@
+package search
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// KVStore is the persistence boundary an Index optionally writes through.
+// It's intentionally tiny so it can be backed by BoltDB, Badger, or any
+// other embedded key/value store without this package depending on one
+// directly; callers wire in an adapter via WithStore. This package ships
+// two implementations: NewMemoryStore (no persistence) and NewFileStore
+// (real disk persistence using only the standard library, for callers who
+// don't want a third-party embedded-database dependency). A genuine
+// BoltDB/Badger adapter is a thin wrapper over the same four methods and
+// is left for whoever first needs that dependency in their binary.
+type KVStore interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte) error
+	Delete(key string) error
+	Close() error
+}
+
+// ErrNotFound is returned by a KVStore's Get when key isn't present.
+var ErrNotFound = fmt.Errorf("search: key not found")
+
+// memoryStore is a KVStore backed by a plain map, with no persistence
+// across process restarts. It's mainly useful in tests, or as a stand-in
+// before wiring up NewFileStore or a BoltDB/Badger-backed KVStore.
+type memoryStore struct {
+	data map[string][]byte
+}
+
+// NewMemoryStore returns a KVStore with no actual persistence.
+func NewMemoryStore() KVStore {
+	return &memoryStore{data: make(map[string][]byte)}
+}
+
+func (s *memoryStore) Get(key string) ([]byte, error) {
+	v, ok := s.data[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+
+func (s *memoryStore) Set(key string, value []byte) error {
+	s.data[key] = value
+	return nil
+}
+
+func (s *memoryStore) Delete(key string) error {
+	delete(s.data, key)
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+// fileStore is a KVStore that persists each key as one file under dir,
+// surviving process restarts. Keys are hex-encoded before use as a
+// filename, so arbitrary key strings (including ones containing path
+// separators) can't escape dir or collide on case-insensitive filesystems.
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a KVStore that persists to dir, creating it if
+// necessary. It's a plain one-file-per-key store, not a transactional
+// database — adequate for the handful of keys an Index writes (typically
+// just indexKey), but callers needing concurrent multi-process access or
+// ACID guarantees across many keys should wire in a real embedded database
+// behind the same KVStore interface instead.
+func NewFileStore(dir string) (KVStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("search: creating store dir: %w", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) path(key string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(key))+".bin")
+}
+
+func (s *fileStore) Get(key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *fileStore) Set(key string, value []byte) error {
+	return os.WriteFile(s.path(key), value, 0o644)
+}
+
+func (s *fileStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package search
+
+import "testing"
+
+func TestFileStore_RoundTrip(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.Get("missing"); err != ErrNotFound {
+		t.Fatalf("Get on missing key: got %v, want ErrNotFound", err)
+	}
+
+	if err := store.Set("a/b", []byte("hello")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	v, err := store.Get("a/b")
+	if err != nil || string(v) != "hello" {
+		t.Fatalf("Get: got (%q, %v), want (\"hello\", nil)", v, err)
+	}
+
+	if err := store.Delete("a/b"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Get("a/b"); err != ErrNotFound {
+		t.Fatalf("Get after Delete: got %v, want ErrNotFound", err)
+	}
+}
+
+// TestFileStore_PersistsAcrossInstances confirms data written by one
+// fileStore is visible to a fresh one pointed at the same directory,
+// proving this is real disk persistence rather than an in-process cache.
+func TestFileStore_PersistsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+
+	store1, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	if err := store1.Set(indexKey, []byte(`{"pages":{}}`)); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	store2, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	v, err := store2.Get(indexKey)
+	if err != nil || string(v) != `{"pages":{}}` {
+		t.Fatalf("Get from fresh store: got (%q, %v)", v, err)
+	}
+}
+
+func TestIndex_WithStore_PersistsAndReloads(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	idx := NewIndex(WithStore(store))
+	if err := idx.Add(titlePage("p1", "alpha")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	reopened := NewIndex(WithStore(store))
+	results := reopened.Query("alpha")
+	if len(results) != 1 || results[0].DocID != "p1" {
+		t.Fatalf("reopened index: got %v, want a single match on p1", results)
+	}
+}
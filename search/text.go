@@ -0,0 +1,83 @@
+package search
+
+// extractText pulls the human-readable text out of a Notion property
+// object, regardless of its type, so it can be analyzed and indexed. The
+// second return value is false for property types that don't carry
+// searchable text (numbers, checkboxes, dates, ...).
+func extractText(propObj map[string]interface{}) (string, bool) {
+	if arr, ok := propObj["title"].([]interface{}); ok {
+		return richTextPlain(arr), true
+	}
+	if arr, ok := propObj["rich_text"].([]interface{}); ok {
+		return richTextPlain(arr), true
+	}
+	if sel, ok := propObj["select"].(map[string]interface{}); ok {
+		name, _ := sel["name"].(string)
+		return name, true
+	}
+	if status, ok := propObj["status"].(map[string]interface{}); ok {
+		name, _ := status["name"].(string)
+		return name, true
+	}
+	if arr, ok := propObj["multi_select"].([]interface{}); ok {
+		return joinNames(arr), true
+	}
+	if rollup, ok := propObj["rollup"].(map[string]interface{}); ok {
+		return rollupText(rollup), true
+	}
+	return "", false
+}
+
+func richTextPlain(arr []interface{}) string {
+	out := ""
+	for _, item := range arr {
+		if m, ok := item.(map[string]interface{}); ok {
+			if s, ok := m["plain_text"].(string); ok {
+				if out != "" {
+					out += " "
+				}
+				out += s
+			}
+		}
+	}
+	return out
+}
+
+func joinNames(arr []interface{}) string {
+	out := ""
+	for _, item := range arr {
+		if m, ok := item.(map[string]interface{}); ok {
+			if name, ok := m["name"].(string); ok {
+				if out != "" {
+					out += " "
+				}
+				out += name
+			}
+		}
+	}
+	return out
+}
+
+func rollupText(rollup map[string]interface{}) string {
+	if rollupType, _ := rollup["type"].(string); rollupType == "array" {
+		arr, _ := rollup["array"].([]interface{})
+		out := ""
+		for _, item := range arr {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if s, ok := extractText(itemMap); ok && s != "" {
+				if out != "" {
+					out += " "
+				}
+				out += s
+			}
+		}
+		return out
+	}
+	if s, ok := rollup["string"].(string); ok {
+		return s
+	}
+	return ""
+}
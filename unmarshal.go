@@ -0,0 +1,411 @@
+package notionapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Unmarshal decodes a page's properties into v, a pointer to a struct whose
+// fields are tagged with `notion:"Property Name,type"`, e.g.:
+//
+//	type Task struct {
+//		Name  string   `notion:"Name,title"`
+//		Owner []string `notion:"Owner,people"`
+//		Price *float64 `notion:"Price,number"`
+//		Tags  []string `notion:"Tags,multi_select"`
+//		Due   *time.Time `notion:"Due,date"`
+//	}
+//
+// Supported types are title, rich_text, number, status, select,
+// multi_select, date, people, checkbox, url, email, phone_number, and the
+// dotted forms formula.<string|number|boolean|date> and
+// rollup.<any property type>. Fields for properties not present on the page
+// at all are left at their zero value. Pointer fields are further left nil
+// when the property is present but reports no value: an empty title/
+// rich_text, an unset select/status/date, or an empty multi_select/people
+// list. checkbox and number are the exception — false and 0 are themselves
+// meaningful values, not "unset" — so a *bool field is only nil when the
+// property key is missing from the page entirely, and a *float64 field only
+// when the property is missing or its number is null. Unmarshal does
+// not replace the GetXxx helpers, which remain available for ad-hoc access.
+func Unmarshal(page map[string]interface{}, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("notionapi: Unmarshal target must be a non-nil pointer to a struct")
+	}
+
+	props, _ := page["properties"].(map[string]interface{})
+
+	structVal := rv.Elem()
+	structType := structVal.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("notion")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		name, kind, subKind, err := parseNotionTag(tag)
+		if err != nil {
+			return fmt.Errorf("notionapi: field %s: %w", field.Name, err)
+		}
+
+		propObj, ok := props[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		val, err := decodeProperty(propObj, kind, subKind)
+		if err != nil {
+			return fmt.Errorf("notionapi: field %s (%s): %w", field.Name, tag, err)
+		}
+		if val == nil {
+			continue
+		}
+
+		if err := assignField(structVal.Field(i), val); err != nil {
+			return fmt.Errorf("notionapi: field %s (%s): %w", field.Name, tag, err)
+		}
+	}
+
+	return nil
+}
+
+// parseNotionTag splits a `notion:"Name,type"` or `notion:"Name,type.subtype"`
+// tag into its property name, type, and optional subtype (used by formula
+// and rollup properties).
+func parseNotionTag(tag string) (name, kind, subKind string, err error) {
+	parts := strings.SplitN(tag, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("malformed notion tag %q, want \"Property Name,type\"", tag)
+	}
+
+	name = parts[0]
+	kind = parts[1]
+	if dot := strings.IndexByte(kind, '.'); dot >= 0 {
+		subKind = kind[dot+1:]
+		kind = kind[:dot]
+	}
+	return name, kind, subKind, nil
+}
+
+// decodeProperty extracts a plain Go value (string, float64, bool,
+// time.Time, []string, or []interface{}) from a single Notion property
+// object, according to kind/subKind. It returns a nil value, nil error when
+// the property is present but empty.
+func decodeProperty(propObj map[string]interface{}, kind, subKind string) (interface{}, error) {
+	switch kind {
+	case "title":
+		if s := plainText(propObj["title"]); s != "" {
+			return s, nil
+		}
+		return nil, nil
+	case "rich_text":
+		if s := plainText(propObj["rich_text"]); s != "" {
+			return s, nil
+		}
+		return nil, nil
+	case "number":
+		n, ok := propObj["number"].(float64)
+		if !ok {
+			return nil, nil
+		}
+		return n, nil
+	case "checkbox":
+		b, _ := propObj["checkbox"].(bool)
+		return b, nil
+	case "url":
+		return propObj["url"], nil
+	case "email":
+		return propObj["email"], nil
+	case "phone_number":
+		return propObj["phone_number"], nil
+	case "status":
+		if name, ok := namedField(propObj["status"]); ok {
+			return name, nil
+		}
+		return nil, nil
+	case "select":
+		if name, ok := namedField(propObj["select"]); ok {
+			return name, nil
+		}
+		return nil, nil
+	case "multi_select":
+		if names := multiSelectNames(propObj["multi_select"]); len(names) > 0 {
+			return names, nil
+		}
+		return nil, nil
+	case "people":
+		if names := peopleNames(propObj["people"]); len(names) > 0 {
+			return names, nil
+		}
+		return nil, nil
+	case "date":
+		return decodeDate(propObj["date"])
+	case "formula":
+		return decodeFormula(propObj["formula"], subKind)
+	case "rollup":
+		return decodeRollup(propObj["rollup"], subKind)
+	default:
+		return nil, fmt.Errorf("unsupported property type %q", kind)
+	}
+}
+
+// plainText concatenates the plain_text of every item in a title or
+// rich_text array.
+func plainText(richText interface{}) string {
+	arr, _ := richText.([]interface{})
+	var sb strings.Builder
+	for _, item := range arr {
+		if m, ok := item.(map[string]interface{}); ok {
+			if s, ok := m["plain_text"].(string); ok {
+				sb.WriteString(s)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// namedField extracts the "name" field from a status/select object. ok is
+// false when the property hasn't been set (field is null) or carries no
+// name.
+func namedField(field interface{}) (name string, ok bool) {
+	m, isObj := field.(map[string]interface{})
+	if !isObj {
+		return "", false
+	}
+	name, ok = m["name"].(string)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+func multiSelectNames(field interface{}) []string {
+	arr, _ := field.([]interface{})
+	names := make([]string, 0, len(arr))
+	for _, item := range arr {
+		if m, ok := item.(map[string]interface{}); ok {
+			if name, ok := m["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// peopleNames extracts a display name per person, falling back to their
+// email or id if no name is set on the workspace member.
+func peopleNames(field interface{}) []string {
+	arr, _ := field.([]interface{})
+	names := make([]string, 0, len(arr))
+	for _, item := range arr {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := m["name"].(string); ok && name != "" {
+			names = append(names, name)
+			continue
+		}
+		if person, ok := m["person"].(map[string]interface{}); ok {
+			if email, ok := person["email"].(string); ok && email != "" {
+				names = append(names, email)
+				continue
+			}
+		}
+		if id, ok := m["id"].(string); ok {
+			names = append(names, id)
+		}
+	}
+	return names
+}
+
+// decodeDate parses a Notion date property's "start" value into a
+// time.Time. It accepts both date-only ("2024-01-05") and full RFC 3339
+// timestamps (which carry a timezone offset), returning a nil value, nil
+// error when the date hasn't been set.
+func decodeDate(field interface{}) (interface{}, error) {
+	m, ok := field.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	start, _ := m["start"].(string)
+	if start == "" {
+		return nil, nil
+	}
+	return parseNotionTime(start)
+}
+
+func parseNotionTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date format %q", s)
+}
+
+func decodeFormula(field interface{}, subKind string) (interface{}, error) {
+	m, ok := field.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	switch subKind {
+	case "string":
+		return m["string"], nil
+	case "number":
+		return m["number"], nil
+	case "boolean":
+		return m["boolean"], nil
+	case "date":
+		return decodeDate(m["date"])
+	case "":
+		return nil, fmt.Errorf("formula fields require a subtype, e.g. `notion:\"Name,formula.string\"`")
+	default:
+		return nil, fmt.Errorf("unsupported formula subtype %q", subKind)
+	}
+}
+
+// decodeRollup decodes a rollup property. When Notion aggregates the
+// rollup (sum, count, ...) the result is a scalar read directly off the
+// rollup object; when it shows the original values the result is an array,
+// and subKind describes the property type of each item.
+func decodeRollup(field interface{}, subKind string) (interface{}, error) {
+	m, ok := field.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	if subKind == "" {
+		return nil, fmt.Errorf("rollup fields require a subtype, e.g. `notion:\"Name,rollup.rich_text\"`")
+	}
+
+	rollupType, _ := m["type"].(string)
+	if rollupType == "array" {
+		arr, _ := m["array"].([]interface{})
+		values := make([]interface{}, 0, len(arr))
+		for _, item := range arr {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			v, err := decodeProperty(itemMap, subKind, "")
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				values = append(values, v)
+			}
+		}
+		return values, nil
+	}
+
+	// Aggregated rollup: the value lives directly under its type's key.
+	return m[rollupType], nil
+}
+
+// assignField sets field to val, converting between the JSON-decoded shape
+// of val (string, float64, bool, time.Time, []string, []interface{}) and
+// the struct field's declared type. Pointer fields are allocated on demand.
+func assignField(field reflect.Value, val interface{}) error {
+	if !field.CanSet() {
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		elem := reflect.New(field.Type().Elem())
+		if err := assignField(elem.Elem(), val); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("cannot assign %T to string field", val)
+		}
+		field.SetString(s)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat64(val)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := toFloat64(val)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(f))
+
+	case reflect.Bool:
+		b, ok := val.(bool)
+		if !ok {
+			return fmt.Errorf("cannot assign %T to bool field", val)
+		}
+		field.SetBool(b)
+
+	case reflect.Slice:
+		return assignSlice(field, val)
+
+	case reflect.Struct:
+		if field.Type() == reflect.TypeOf(time.Time{}) {
+			t, ok := val.(time.Time)
+			if !ok {
+				return fmt.Errorf("cannot assign %T to time.Time field", val)
+			}
+			field.Set(reflect.ValueOf(t))
+			return nil
+		}
+		return fmt.Errorf("unsupported struct field type %s", field.Type())
+
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+
+	return nil
+}
+
+func assignSlice(field reflect.Value, val interface{}) error {
+	switch vals := val.(type) {
+	case []string:
+		out := reflect.MakeSlice(field.Type(), len(vals), len(vals))
+		for i, s := range vals {
+			if err := assignField(out.Index(i), s); err != nil {
+				return err
+			}
+		}
+		field.Set(out)
+		return nil
+
+	case []interface{}:
+		out := reflect.MakeSlice(field.Type(), len(vals), len(vals))
+		for i, v := range vals {
+			if err := assignField(out.Index(i), v); err != nil {
+				return err
+			}
+		}
+		field.Set(out)
+		return nil
+
+	default:
+		return fmt.Errorf("cannot assign %T to slice field", val)
+	}
+}
+
+func toFloat64(val interface{}) (float64, error) {
+	f, ok := val.(float64)
+	if !ok {
+		return 0, fmt.Errorf("cannot assign %T to numeric field", val)
+	}
+	return f, nil
+}
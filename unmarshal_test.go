@@ -0,0 +1,100 @@
+package notionapi
+
+import "testing"
+
+func TestUnmarshal_PointerNilSemantics(t *testing.T) {
+	type target struct {
+		MissingTitle  *string  `notion:"Missing,title"`
+		EmptyTitle    *string  `notion:"EmptyTitle,title"`
+		FilledTitle   *string  `notion:"FilledTitle,title"`
+		EmptyText     *string  `notion:"EmptyText,rich_text"`
+		UnsetSelect   *string  `notion:"UnsetSelect,select"`
+		SetSelect     *string  `notion:"SetSelect,select"`
+		UnsetStatus   *string  `notion:"UnsetStatus,status"`
+		EmptyMulti    []string `notion:"EmptyMulti,multi_select"`
+		EmptyPeople   []string `notion:"EmptyPeople,people"`
+		UncheckedBox  *bool    `notion:"UncheckedBox,checkbox"`
+		ZeroNumber    *float64 `notion:"ZeroNumber,number"`
+		MissingNumber *float64 `notion:"MissingNumber,number"`
+	}
+
+	page := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"EmptyTitle":   map[string]interface{}{"title": []interface{}{}},
+			"FilledTitle":  map[string]interface{}{"title": []interface{}{map[string]interface{}{"plain_text": "hi"}}},
+			"EmptyText":    map[string]interface{}{"rich_text": []interface{}{}},
+			"UnsetSelect":  map[string]interface{}{"select": nil},
+			"SetSelect":    map[string]interface{}{"select": map[string]interface{}{"name": "Done"}},
+			"UnsetStatus":  map[string]interface{}{"status": nil},
+			"EmptyMulti":   map[string]interface{}{"multi_select": []interface{}{}},
+			"EmptyPeople":  map[string]interface{}{"people": []interface{}{}},
+			"UncheckedBox": map[string]interface{}{"checkbox": false},
+			"ZeroNumber":   map[string]interface{}{"number": float64(0)},
+		},
+	}
+
+	var got target
+	if err := Unmarshal(page, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got.MissingTitle != nil {
+		t.Error("MissingTitle: want nil for a property absent from the page")
+	}
+	if got.EmptyTitle != nil {
+		t.Error("EmptyTitle: want nil for an empty title")
+	}
+	if got.FilledTitle == nil || *got.FilledTitle != "hi" {
+		t.Errorf("FilledTitle: got %v, want \"hi\"", got.FilledTitle)
+	}
+	if got.EmptyText != nil {
+		t.Error("EmptyText: want nil for empty rich_text")
+	}
+	if got.UnsetSelect != nil {
+		t.Error("UnsetSelect: want nil for an unset select")
+	}
+	if got.SetSelect == nil || *got.SetSelect != "Done" {
+		t.Errorf("SetSelect: got %v, want \"Done\"", got.SetSelect)
+	}
+	if got.UnsetStatus != nil {
+		t.Error("UnsetStatus: want nil for an unset status")
+	}
+	if got.EmptyMulti != nil {
+		t.Error("EmptyMulti: want nil for an empty multi_select")
+	}
+	if got.EmptyPeople != nil {
+		t.Error("EmptyPeople: want nil for an empty people list")
+	}
+
+	// checkbox and number are the documented exception: false/0 are
+	// meaningful values, so the pointer stays nil only when the property
+	// key is entirely missing (or, for number, when its value is null).
+	if got.UncheckedBox == nil || *got.UncheckedBox != false {
+		t.Errorf("UncheckedBox: got %v, want a non-nil false", got.UncheckedBox)
+	}
+	if got.ZeroNumber == nil || *got.ZeroNumber != 0 {
+		t.Errorf("ZeroNumber: got %v, want a non-nil 0", got.ZeroNumber)
+	}
+	if got.MissingNumber != nil {
+		t.Error("MissingNumber: want nil for a property absent from the page")
+	}
+}
+
+func TestUnmarshal_SliceTypeMismatchReturnsError(t *testing.T) {
+	type target struct {
+		Tags []float64 `notion:"Tags,multi_select"`
+	}
+
+	page := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"Tags": map[string]interface{}{
+				"multi_select": []interface{}{map[string]interface{}{"name": "a"}},
+			},
+		},
+	}
+
+	var got target
+	if err := Unmarshal(page, &got); err == nil {
+		t.Fatal("expected an error for a []string value assigned to a []float64 field, got nil")
+	}
+}
@@ -0,0 +1,149 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// writeOptions holds the per-call settings configured by WriteOption.
+type writeOptions struct {
+	idempotencyKey string
+}
+
+// WriteOption configures a single create/update call.
+type WriteOption func(*writeOptions)
+
+// WithIdempotencyKey attaches an Idempotency-Key header to the request, so
+// retrying the same call (e.g. after a timeout) is safe to repeat without
+// creating duplicate pages.
+func WithIdempotencyKey(key string) WriteOption {
+	return func(o *writeOptions) { o.idempotencyKey = key }
+}
+
+func buildWriteOptions(opts []WriteOption) *writeOptions {
+	o := &writeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *writeOptions) headers() map[string]string {
+	if o.idempotencyKey == "" {
+		return nil
+	}
+	return map[string]string{"Idempotency-Key": o.idempotencyKey}
+}
+
+// CreatePage creates a new page under the database databaseID, with the
+// given properties (typically built with props.Builder.Build).
+func (c *Client) CreatePage(ctx context.Context, databaseID string, properties map[string]interface{}, opts ...WriteOption) (Page, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"parent":     map[string]interface{}{"database_id": databaseID},
+		"properties": properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.writePage(ctx, "POST", c.baseURL+"/pages", payload, opts)
+}
+
+// UpdatePage overwrites the given properties on pageID, leaving properties
+// not present in properties untouched.
+func (c *Client) UpdatePage(ctx context.Context, pageID string, properties map[string]interface{}, opts ...WriteOption) (Page, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"properties": properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.writePage(ctx, "PATCH", c.baseURL+"/pages/"+pageID, payload, opts)
+}
+
+// ArchivePage moves pageID to the trash. Notion has no hard-delete API;
+// archiving is the supported way to remove a page.
+func (c *Client) ArchivePage(ctx context.Context, pageID string, opts ...WriteOption) (Page, error) {
+	payload, err := json.Marshal(map[string]interface{}{"archived": true})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.writePage(ctx, "PATCH", c.baseURL+"/pages/"+pageID, payload, opts)
+}
+
+// RetrievePage fetches a single page by ID.
+func (c *Client) RetrievePage(ctx context.Context, pageID string) (Page, error) {
+	return c.writePage(ctx, "GET", c.baseURL+"/pages/"+pageID, nil, nil)
+}
+
+// AppendBlockChildren appends children to the end of blockID's children
+// list. blockID may be a page ID, since every page is also a block.
+func (c *Client) AppendBlockChildren(ctx context.Context, blockID string, children []map[string]interface{}, opts ...WriteOption) (map[string]interface{}, error) {
+	payload, err := json.Marshal(map[string]interface{}{"children": children})
+	if err != nil {
+		return nil, err
+	}
+
+	o := buildWriteOptions(opts)
+	body, err := c.doRequest(ctx, "PATCH", c.baseURL+"/blocks/"+blockID+"/children", payload, o.headers())
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RetrieveDatabase fetches a database's schema and metadata.
+func (c *Client) RetrieveDatabase(ctx context.Context, databaseID string) (map[string]interface{}, error) {
+	body, err := c.doRequest(ctx, "GET", c.baseURL+"/databases/"+databaseID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateDatabase applies updates (e.g. "title" or "properties" schema
+// changes) to databaseID.
+func (c *Client) UpdateDatabase(ctx context.Context, databaseID string, updates map[string]interface{}, opts ...WriteOption) (map[string]interface{}, error) {
+	payload, err := json.Marshal(updates)
+	if err != nil {
+		return nil, err
+	}
+
+	o := buildWriteOptions(opts)
+	body, err := c.doRequest(ctx, "PATCH", c.baseURL+"/databases/"+databaseID, payload, o.headers())
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) writePage(ctx context.Context, method, url string, payload []byte, opts []WriteOption) (Page, error) {
+	o := buildWriteOptions(opts)
+	body, err := c.doRequest(ctx, method, url, payload, o.headers())
+	if err != nil {
+		return nil, err
+	}
+
+	var page Page
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
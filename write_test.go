@@ -0,0 +1,82 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreatePage(t *testing.T) {
+	var gotMethod, gotURL string
+	var gotBody map[string]interface{}
+	var gotIdempotencyKey string
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		gotMethod = req.Method
+		gotURL = req.URL.String()
+		gotIdempotencyKey = req.Header.Get("Idempotency-Key")
+		_ = json.NewDecoder(req.Body).Decode(&gotBody)
+		return jsonResponse(http.StatusOK, `{"id":"page1"}`, nil), nil
+	})
+
+	props := map[string]interface{}{"Name": map[string]interface{}{"title": "x"}}
+	page, err := client.CreatePage(context.Background(), "db1", props, WithIdempotencyKey("key1"))
+	if err != nil {
+		t.Fatalf("CreatePage: %v", err)
+	}
+	if page["id"] != "page1" {
+		t.Fatalf("got page %v", page)
+	}
+	if gotMethod != "POST" {
+		t.Errorf("method = %q, want POST", gotMethod)
+	}
+	if gotURL != defaultBaseURL+"/pages" {
+		t.Errorf("url = %q, want %s/pages", gotURL, defaultBaseURL)
+	}
+	if gotIdempotencyKey != "key1" {
+		t.Errorf("Idempotency-Key header = %q, want key1", gotIdempotencyKey)
+	}
+	if gotBody["parent"].(map[string]interface{})["database_id"] != "db1" {
+		t.Errorf("parent.database_id not set: %v", gotBody)
+	}
+}
+
+func TestUpdatePage(t *testing.T) {
+	var gotMethod, gotURL string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		gotMethod = req.Method
+		gotURL = req.URL.String()
+		return jsonResponse(http.StatusOK, `{"id":"page1"}`, nil), nil
+	})
+
+	_, err := client.UpdatePage(context.Background(), "page1", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("UpdatePage: %v", err)
+	}
+	if gotMethod != "PATCH" {
+		t.Errorf("method = %q, want PATCH", gotMethod)
+	}
+	if gotURL != defaultBaseURL+"/pages/page1" {
+		t.Errorf("url = %q", gotURL)
+	}
+}
+
+func TestArchivePage(t *testing.T) {
+	var gotBody map[string]interface{}
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		_ = json.NewDecoder(req.Body).Decode(&gotBody)
+		return jsonResponse(http.StatusOK, `{"id":"page1","archived":true}`, nil), nil
+	})
+
+	page, err := client.ArchivePage(context.Background(), "page1")
+	if err != nil {
+		t.Fatalf("ArchivePage: %v", err)
+	}
+	if page["archived"] != true {
+		t.Fatalf("got page %v", page)
+	}
+	if gotBody["archived"] != true {
+		t.Errorf("request body archived = %v, want true", gotBody["archived"])
+	}
+}